@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// NewSkinCmd returns the `skin` command and its subcommands, registered on
+// rootCmd in this package's init().
+func NewSkinCmd() *cobra.Command {
+	skinCmd := &cobra.Command{
+		Use:   "skin",
+		Short: "Manage k9s skins",
+	}
+	skinCmd.AddCommand(newSkinValidateCmd())
+
+	return skinCmd
+}
+
+func newSkinValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate FILE",
+		Short: "Validate a skin file against the k9s skin schema",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := ui.ValidateSkinFile(args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("%s is a valid skin file!\n", args[0])
+			return nil
+		},
+	}
+}
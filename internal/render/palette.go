@@ -0,0 +1,42 @@
+package render
+
+import (
+	"sync/atomic"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Palette is an immutable snapshot of the render colors derived from the
+// active skin. It replaces the former package-level ModColor/AddColor/...
+// vars, which were mutated in place by skin reloads running on an fsnotify
+// goroutine while render code read them from tview's event loop -- a data
+// race. Readers fetch a snapshot once via CurrentPalette and keep using it;
+// a reload never mutates the Palette a caller already holds, it only swaps
+// in a new one.
+type Palette struct {
+	ModColor       tcell.Color
+	AddColor       tcell.Color
+	ErrColor       tcell.Color
+	StdColor       tcell.Color
+	PendingColor   tcell.Color
+	HighlightColor tcell.Color
+	KillColor      tcell.Color
+	CompletedColor tcell.Color
+}
+
+var currentPalette atomic.Pointer[Palette]
+
+func init() {
+	currentPalette.Store(&Palette{})
+}
+
+// CurrentPalette returns the render colors currently in effect.
+func CurrentPalette() *Palette {
+	return currentPalette.Load()
+}
+
+// SetPalette atomically swaps in a new color snapshot, e.g. after a skin
+// reload.
+func SetPalette(p Palette) {
+	currentPalette.Store(&p)
+}
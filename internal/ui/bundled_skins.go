@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// bundledSkins embeds a curated set of default skins so k9s ships with
+// working themes out-of-the-box, with no manual file placement required.
+//
+//go:embed skins/*.yml
+var bundledSkins embed.FS
+
+// bundledSkinFile returns the embedded path for the named bundled skin, or
+// an error if no such skin is bundled.
+func bundledSkinFile(name string) (string, error) {
+	path := filepath.Join("skins", name+".yml")
+	if _, err := fs.Stat(bundledSkins, path); err != nil {
+		return "", os.ErrNotExist
+	}
+	return path, nil
+}
+
+// loadBundledSkin loads the named skin straight out of the embedded FS, for
+// when it can't be found on disk under any of skinDirs.
+func (c *Configurator) loadBundledSkin(styles *config.Styles, name string) bool {
+	path, err := bundledSkinFile(name)
+	if err != nil {
+		return false
+	}
+
+	raw, err := bundledSkins.ReadFile(path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to read bundled skin %s", name)
+		return false
+	}
+
+	tmp, err := os.CreateTemp("", "k9s-skin-*.yml")
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to stage bundled skin %s", name)
+		return false
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	if _, err := tmp.Write(raw); err != nil {
+		log.Warn().Err(err).Msgf("Unable to stage bundled skin %s", name)
+		return false
+	}
+
+	if err := styles.Load(tmp.Name()); err != nil {
+		log.Error().Err(err).Msgf("Failed to parse bundled skin %s", name)
+		return false
+	}
+	// The temp file is removed as soon as we return, so record a synthetic,
+	// non-empty skin name -- this keeps HasSkin() true (skip DefaultSkin) --
+	// and flag it as bundled so StylesWatcher/retargetSkinWatch know there's
+	// no real path on disk to fsnotify.Add.
+	c.applySnapshot(styles, "bundled:"+name, true)
+	return true
+}
+
+// InstallBundledSkin copies the named embedded skin out to destDir so a
+// user can customize it, e.g. `~/.k9s` or `~/.k9s/skins`.
+func (c *Configurator) InstallBundledSkin(name, destDir string) error {
+	path, err := bundledSkinFile(name)
+	if err != nil {
+		return fmt.Errorf("no bundled skin named %q", name)
+	}
+
+	raw, err := bundledSkins.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(destDir, name+".yml"), raw, 0o644)
+}
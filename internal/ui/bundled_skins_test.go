@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+func TestLoadBundledSkinCleansUpTempFile(t *testing.T) {
+	before, err := filepath.Glob(filepath.Join(os.TempDir(), "k9s-skin-*.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c Configurator
+	if !c.loadBundledSkin(config.NewStyles(), "dracula") {
+		t.Fatal("expected the embedded dracula skin to load")
+	}
+
+	after, err := filepath.Glob(filepath.Join(os.TempDir(), "k9s-skin-*.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after) != len(before) {
+		t.Fatalf("expected no leftover staged skin files, before=%d after=%d", len(before), len(after))
+	}
+}
+
+func TestLoadBundledSkinUnknownName(t *testing.T) {
+	var c Configurator
+	if c.loadBundledSkin(config.NewStyles(), "does-not-exist") {
+		t.Fatal("expected an unknown bundled skin name to fail")
+	}
+}
+
+func TestLoadBundledSkinSkipsStylesWatcher(t *testing.T) {
+	var c Configurator
+	if !c.loadBundledSkin(config.NewStyles(), "dracula") {
+		t.Fatal("expected the embedded dracula skin to load")
+	}
+	if !c.HasSkin() {
+		t.Fatal("expected HasSkin to stay true for a bundled skin")
+	}
+
+	// The staged temp file is already gone by the time we get here, so
+	// StylesWatcher must skip it rather than fsnotify.Add a missing path.
+	if err := c.StylesWatcher(context.Background(), noopSynchronizer{}); err != nil {
+		t.Fatalf("expected StylesWatcher to skip a bundled skin cleanly, got %v", err)
+	}
+}
+
+type noopSynchronizer struct{}
+
+func (noopSynchronizer) QueueUpdateDraw(func()) {}
+func (noopSynchronizer) QueueUpdate(func())     {}
@@ -2,10 +2,13 @@ package ui
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
@@ -21,11 +24,52 @@ type synchronizer interface {
 
 // Configurator represents an application configuration.
 type Configurator struct {
-	Config     *config.Config
-	Styles     *config.Styles
-	CustomView *config.CustomView
-	BenchFile  string
-	skinFile   string
+	Config    *config.Config
+	BenchFile string
+	skinFile  string
+	// skinBundled is true when skinFile was served out of the embedded FS
+	// rather than read off disk, so there is no real path for
+	// StylesWatcher/retargetSkinWatch to fsnotify.Add.
+	skinBundled bool
+
+	styles     atomic.Pointer[config.Styles]
+	customView atomic.Pointer[config.CustomView]
+
+	styleWatcher *fsnotify.Watcher
+
+	configListenersMx sync.RWMutex
+	configListeners   []func(old, new *config.Config)
+
+	styleListenersMx sync.RWMutex
+	styleListeners   map[string]func(*config.Styles)
+	styleListenerSeq int
+
+	viewStylesMx sync.RWMutex
+	viewStyles   map[string]*ViewStyle
+
+	lastSkinErrMx sync.RWMutex
+	lastSkinErr   error
+}
+
+// Styles returns an immutable snapshot of the currently active skin. Safe
+// for concurrent use: a skin reload running on another goroutine swaps the
+// snapshot out atomically, it never mutates the one a caller already holds.
+func (c *Configurator) Styles() *config.Styles {
+	if s := c.styles.Load(); s != nil {
+		return s
+	}
+	return config.NewStyles()
+}
+
+// CustomViews returns an immutable snapshot of the currently active view
+// customizations. Safe for concurrent use: a views.yml reload running on
+// another goroutine swaps the snapshot out atomically, it never mutates the
+// one a caller already holds.
+func (c *Configurator) CustomViews() *config.CustomView {
+	if v := c.customView.Load(); v != nil {
+		return v
+	}
+	return config.NewCustomView()
 }
 
 // HasSkin returns true if a skin file was located.
@@ -33,6 +77,53 @@ func (c *Configurator) HasSkin() bool {
 	return c.skinFile != ""
 }
 
+// LastSkinError returns the error from the most recent skin load, or nil if
+// it loaded cleanly. The UI can surface this to flash a validation failure
+// to the user.
+func (c *Configurator) LastSkinError() error {
+	c.lastSkinErrMx.RLock()
+	defer c.lastSkinErrMx.RUnlock()
+	return c.lastSkinErr
+}
+
+func (c *Configurator) setLastSkinError(err error) {
+	c.lastSkinErrMx.Lock()
+	defer c.lastSkinErrMx.Unlock()
+	c.lastSkinErr = err
+}
+
+// AddStyleListener registers a listener invoked with the new style snapshot
+// every time a skin reload is applied. Returns an id that can later be
+// passed to RemoveStyleListener.
+func (c *Configurator) AddStyleListener(l func(*config.Styles)) string {
+	c.styleListenersMx.Lock()
+	defer c.styleListenersMx.Unlock()
+
+	if c.styleListeners == nil {
+		c.styleListeners = make(map[string]func(*config.Styles))
+	}
+	c.styleListenerSeq++
+	id := fmt.Sprintf("style-listener-%d", c.styleListenerSeq)
+	c.styleListeners[id] = l
+	return id
+}
+
+// RemoveStyleListener unregisters a listener previously added via
+// AddStyleListener.
+func (c *Configurator) RemoveStyleListener(id string) {
+	c.styleListenersMx.Lock()
+	defer c.styleListenersMx.Unlock()
+	delete(c.styleListeners, id)
+}
+
+func (c *Configurator) fireStyleChange(s *config.Styles) {
+	c.styleListenersMx.RLock()
+	defer c.styleListenersMx.RUnlock()
+	for _, l := range c.styleListeners {
+		l(s)
+	}
+}
+
 // CustomViewsWatcher watches for view config file changes.
 func (c *Configurator) CustomViewsWatcher(ctx context.Context, s synchronizer) error {
 	w, err := fsnotify.NewWatcher()
@@ -66,23 +157,161 @@ func (c *Configurator) CustomViewsWatcher(ctx context.Context, s synchronizer) e
 	return w.Add(config.K9sViewConfigFile)
 }
 
-// RefreshCustomViews load view configuration changes.
+// RefreshCustomViews load view configuration changes. Like RefreshStyles, it
+// builds a fresh, private config.CustomView and only publishes it once fully
+// loaded, so concurrent readers never observe a half-loaded one.
 func (c *Configurator) RefreshCustomViews() {
-	if c.CustomView == nil {
-		c.CustomView = config.NewCustomView()
-	} else {
-		c.CustomView.Reset()
-	}
+	view := config.NewCustomView()
 
-	if err := c.CustomView.Load(config.K9sViewConfigFile); err != nil {
+	if err := view.Load(config.K9sViewConfigFile); err != nil {
 		log.Warn().Err(err).Msgf("Custom view load failed %s", config.K9sViewConfigFile)
 		return
 	}
+	c.customView.Store(view)
+
+	// config.CustomView does not keep the per-GVR `style:` blocks, so read
+	// views.yml's raw bytes here and hand them to RefreshViewStyles, which
+	// parses them for style overrides.
+	raw, err := os.ReadFile(config.K9sViewConfigFile)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to read view style overrides %s", config.K9sViewConfigFile)
+		return
+	}
+	c.RefreshViewStyles(raw)
+}
+
+// OnConfigChange registers a listener that is invoked whenever ConfigWatcher
+// hot-reloads the main k9s config. Listeners receive both the previous and
+// the newly loaded configuration so they can diff the parts they care about
+// (render colors, view state, etc) without re-reading the file themselves.
+func (c *Configurator) OnConfigChange(l func(old, new *config.Config)) {
+	c.configListenersMx.Lock()
+	defer c.configListenersMx.Unlock()
+	c.configListeners = append(c.configListeners, l)
+}
+
+func (c *Configurator) fireConfigChange(old, new *config.Config) {
+	c.configListenersMx.RLock()
+	defer c.configListenersMx.RUnlock()
+	for _, l := range c.configListeners {
+		l(old, new)
+	}
+}
+
+// cloneK9s deep-copies a config.K9s via a JSON round-trip so a caller can
+// hold on to a "before" snapshot across a Config.Load that unmarshals into
+// the original in place. Falls back to returning the same pointer -- still
+// correct for callers that only read it once immediately -- if the
+// round-trip itself fails, which should never happen for a struct Load
+// already successfully unmarshaled into.
+func cloneK9s(k9s *config.K9s) *config.K9s {
+	if k9s == nil {
+		return nil
+	}
+	raw, err := json.Marshal(k9s)
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to snapshot k9s config for change diff")
+		return k9s
+	}
+	var clone config.K9s
+	if err := json.Unmarshal(raw, &clone); err != nil {
+		log.Warn().Err(err).Msg("Unable to snapshot k9s config for change diff")
+		return k9s
+	}
+	return &clone
+}
+
+// ConfigWatcher watches for changes to the main k9s config file and
+// hot-reloads it -- refresh rate, log buffers, cluster skin and RBAC
+// toggles -- without requiring a restart.
+func (c *Configurator) ConfigWatcher(ctx context.Context, s synchronizer) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case evt := <-w.Events:
+				if evt.Op != fsnotify.Chmod {
+					s.QueueUpdateDraw(func() {
+						c.reloadConfig()
+					})
+				}
+			case err := <-w.Errors:
+				log.Warn().Err(err).Msg("Config watcher failed")
+				return
+			case <-ctx.Done():
+				log.Debug().Msgf("ConfigWatcher CANCELED `%s!!", config.K9sConfigFile)
+				if err := w.Close(); err != nil {
+					log.Error().Err(err).Msg("Closing Config watcher")
+				}
+				return
+			}
+		}
+	}()
+
+	log.Debug().Msgf("ConfigWatcher watching `%s", config.K9sConfigFile)
+	return w.Add(config.K9sConfigFile)
+}
+
+// reloadConfig re-reads the main k9s config from disk, fans the diff out to
+// registered listeners and hot-swaps the skin if the active cluster changed
+// underneath us.
+func (c *Configurator) reloadConfig() {
+	// c.Config.K9s is a pointer field that Load unmarshals into in place, so
+	// a shallow copy of *c.Config would alias c.Config.K9s after Load
+	// returns and every field reachable through it would compare as
+	// unchanged. Deep-copy K9s itself before Load so listeners get a real
+	// "before" snapshot.
+	oldConfig := *c.Config
+	oldConfig.K9s = cloneK9s(c.Config.K9s)
+	oldCluster := oldConfig.K9s.CurrentCluster
+
+	if err := c.Config.Load(config.K9sConfigFile); err != nil {
+		log.Warn().Err(err).Msgf("Config reload failed %s", config.K9sConfigFile)
+		return
+	}
+
+	newCluster := c.Config.K9s.CurrentCluster
+	if oldCluster != newCluster {
+		c.retargetSkinWatch(oldCluster, newCluster)
+	} else {
+		c.RefreshStyles(
+			newCluster,
+			c.Config.K9s.Skin,
+			c.Config.K9s.GetManualSkin(),
+		)
+	}
+
+	c.fireConfigChange(&oldConfig, c.Config)
+}
+
+// retargetSkinWatch moves the running StylesWatcher from the skin of the
+// previous cluster to the skin of the newly selected one, so switching
+// contexts from within the UI hot-swaps to the context-specific
+// `<ctx>_skin.yml` that RefreshStyles already looks for.
+func (c *Configurator) retargetSkinWatch(oldCluster, newCluster string) {
+	if c.styleWatcher != nil && c.skinFile != "" && !c.skinBundled {
+		if err := c.styleWatcher.Remove(c.skinFile); err != nil {
+			log.Debug().Err(err).Msgf("Unable to unwatch skin file %s", c.skinFile)
+		}
+	}
+
+	c.RefreshStyles(newCluster, c.Config.K9s.Skin, c.Config.K9s.GetManualSkin())
+
+	if c.styleWatcher != nil && c.HasSkin() && !c.skinBundled {
+		if err := c.styleWatcher.Add(c.skinFile); err != nil {
+			log.Warn().Err(err).Msgf("Unable to watch skin file %s", c.skinFile)
+		}
+	}
 }
 
-// StylesWatcher watches for skin file changes.
+// StylesWatcher watches for skin file changes. Bundled skins are served out
+// of the embedded FS with no backing file, so there is nothing to watch.
 func (c *Configurator) StylesWatcher(ctx context.Context, s synchronizer) error {
-	if !c.HasSkin() {
+	if !c.HasSkin() || c.skinBundled {
 		return nil
 	}
 
@@ -90,6 +319,7 @@ func (c *Configurator) StylesWatcher(ctx context.Context, s synchronizer) error
 	if err != nil {
 		return err
 	}
+	c.styleWatcher = w
 
 	go func() {
 		for {
@@ -126,76 +356,130 @@ func BenchConfig(context string) string {
 	return filepath.Join(config.K9sHome(), config.K9sBench+"-"+context+".yml")
 }
 
-// RefreshStyles load for skin configuration changes.
+// RefreshStyles load for skin configuration changes. It always builds a
+// fresh, private config.Styles and only publishes it -- via applySnapshot --
+// once fully populated, so concurrent readers never observe a half-loaded
+// skin.
 func (c *Configurator) RefreshStyles(context string, configuredStyle string, manualStyle string) {
 	c.BenchFile = BenchConfig(context)
+	c.setLastSkinError(nil)
 
-	if c.Styles == nil {
-		c.Styles = config.NewStyles()
-	} else {
-		c.Styles.Reset()
-	}
+	styles := config.NewStyles()
 
 	// Check skin from cli args
-	if manualStyle != "" && c.updateStylesWithFile(manualStyle) {
-		return
+	if manualStyle != "" {
+		if c.updateStylesWithFile(styles, manualStyle) {
+			return
+		}
+		if c.LastSkinError() != nil {
+			return
+		}
 	}
 
 	// Check context specific skin files
-	if context != "" && c.updateStylesWithFile(fmt.Sprintf("%s_skin", context)) {
-		return
+	if context != "" {
+		if c.updateStylesWithFile(styles, fmt.Sprintf("%s_skin", context)) {
+			return
+		}
+		if c.LastSkinError() != nil {
+			return
+		}
 	}
 
 	// Check prefered skin set from k9s config
-	if configuredStyle != "" && c.updateStylesWithFile(configuredStyle) {
-		return
+	if configuredStyle != "" {
+		if c.updateStylesWithFile(styles, configuredStyle) {
+			return
+		}
+		if c.LastSkinError() != nil {
+			return
+		}
 	}
 
 	// Check default files (skin.yml/skin.yaml)
-	if c.updateStylesWithFile(config.K9sDefaultSkin) {
+	if c.updateStylesWithFile(styles, config.K9sDefaultSkin) {
+		return
+	}
+	if c.LastSkinError() != nil {
 		return
 	}
 
-	c.updateStyles("")
+	c.applySnapshot(styles, "", false)
 }
 
-func (c *Configurator) updateStylesWithFile(skinName string) bool {
-	for _, extension := range config.K9sStylesFileExtensions {
-		manualSkin := filepath.Join(config.K9sHome(), fmt.Sprintf("%s.%s", skinName, extension))
-		if c.loadSkinFile(manualSkin) {
-			return true
+func (c *Configurator) updateStylesWithFile(styles *config.Styles, skinName string) bool {
+	for _, dir := range c.skinDirs() {
+		for _, extension := range config.K9sStylesFileExtensions {
+			manualSkin := filepath.Join(dir, fmt.Sprintf("%s.%s", skinName, extension))
+			if c.loadSkinFile(styles, manualSkin) {
+				return true
+			}
+			if c.LastSkinError() != nil {
+				return false
+			}
 		}
 	}
-	return false
+
+	// Not on disk -- fall back to one of the skins bundled in the binary so
+	// e.g. `--skin dracula` works on a fresh install with no files placed.
+	return c.loadBundledSkin(styles, skinName)
 }
 
-func (c *Configurator) loadSkinFile(fileName string) bool {
-	if err := c.Styles.Load(fileName); err != nil {
+func (c *Configurator) loadSkinFile(styles *config.Styles, fileName string) bool {
+	effective, cleanup, err := c.resolveSkinFile(fileName)
+	defer cleanup()
+	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			log.Warn().Msgf("No skin file found -- %s", fileName)
 		} else {
+			c.setLastSkinError(err)
 			log.Error().Msgf("Failed to parse skin file -- %s. %s.", fileName, err)
 		}
 		return false
-	} else {
-		c.updateStyles(fileName)
-		return true
 	}
+
+	// Validate against the config.Styles JSON Schema before touching
+	// styles.Load: on a validation failure we keep whatever was already
+	// applied rather than silently falling back to defaults mid-session.
+	if err := ValidateSkinFile(effective); err != nil {
+		c.setLastSkinError(err)
+		log.Error().Err(err).Msgf("Skin validation failed -- %s", fileName)
+		return false
+	}
+
+	if err := styles.Load(effective); err != nil {
+		c.setLastSkinError(err)
+		log.Error().Msgf("Failed to parse skin file -- %s. %s.", fileName, err)
+		return false
+	}
+	c.applySnapshot(styles, fileName, false)
+	return true
 }
 
-func (c *Configurator) updateStyles(f string) {
+// applySnapshot is the single place a loaded skin becomes visible to the
+// rest of the app: it records the skin file, derives the render.Palette and
+// atomically swaps both in, then fans the new snapshot out to style
+// listeners.
+func (c *Configurator) applySnapshot(styles *config.Styles, f string, bundled bool) {
 	c.skinFile = f
+	c.skinBundled = bundled
 	if !c.HasSkin() {
-		c.Styles.DefaultSkin()
-	}
-	c.Styles.Update()
-
-	render.ModColor = c.Styles.Frame().Status.ModifyColor.Color()
-	render.AddColor = c.Styles.Frame().Status.AddColor.Color()
-	render.ErrColor = c.Styles.Frame().Status.ErrorColor.Color()
-	render.StdColor = c.Styles.Frame().Status.NewColor.Color()
-	render.PendingColor = c.Styles.Frame().Status.PendingColor.Color()
-	render.HighlightColor = c.Styles.Frame().Status.HighlightColor.Color()
-	render.KillColor = c.Styles.Frame().Status.KillColor.Color()
-	render.CompletedColor = c.Styles.Frame().Status.CompletedColor.Color()
+		styles.DefaultSkin()
+	}
+	styles.Update()
+
+	c.styles.Store(styles)
+
+	render.SetPalette(render.Palette{
+		ModColor:       styles.Frame().Status.ModifyColor.Color(),
+		AddColor:       styles.Frame().Status.AddColor.Color(),
+		ErrColor:       styles.Frame().Status.ErrorColor.Color(),
+		StdColor:       styles.Frame().Status.NewColor.Color(),
+		PendingColor:   styles.Frame().Status.PendingColor.Color(),
+		HighlightColor: styles.Frame().Status.HighlightColor.Color(),
+		KillColor:      styles.Frame().Status.KillColor.Color(),
+		CompletedColor: styles.Frame().Status.CompletedColor.Color(),
+	})
+
+	c.fireStyleChange(styles)
 }
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+)
+
+// TestConfiguratorConcurrentRefresh exercises concurrent RefreshStyles calls
+// against concurrent render reads. Run with `go test -race`: before the
+// atomic snapshot refactor this reliably tripped the race detector on both
+// the Styles pointer and the render color globals.
+func TestConfiguratorConcurrentRefresh(t *testing.T) {
+	var c Configurator
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			c.RefreshStyles("", "", "")
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			_ = c.Styles()
+			_ = render.CurrentPalette()
+		}
+	}()
+
+	wg.Wait()
+}
+
+// TestOnConfigChangeSeesIndependentSnapshots guards against the K9s pointer
+// field aliasing regression: Config.Load unmarshals into c.Config.K9s in
+// place, so a listener must receive a genuinely independent "old" K9s, not
+// one that silently picked up the new values because it shared the same
+// backing struct.
+func TestOnConfigChangeSeesIndependentSnapshots(t *testing.T) {
+	var c Configurator
+	c.Config = &config.Config{K9s: &config.K9s{CurrentCluster: "c1", Skin: "skin-old"}}
+
+	var old, new *config.Config
+	c.OnConfigChange(func(o, n *config.Config) {
+		old, new = o, n
+	})
+
+	oldConfig := *c.Config
+	oldConfig.K9s = cloneK9s(c.Config.K9s)
+
+	// Mimic what Config.Load does: mutate K9s in place rather than
+	// replacing the pointer.
+	c.Config.K9s.CurrentCluster = "c2"
+	c.Config.K9s.Skin = "skin-new"
+
+	c.fireConfigChange(&oldConfig, c.Config)
+
+	if old.K9s.Skin != "skin-old" {
+		t.Fatalf("expected old snapshot to keep skin-old, got %q", old.K9s.Skin)
+	}
+	if new.K9s.Skin != "skin-new" {
+		t.Fatalf("expected new snapshot to see skin-new, got %q", new.K9s.Skin)
+	}
+	if old.K9s == new.K9s {
+		t.Fatal("expected old and new K9s to be independent structs, not the same pointer")
+	}
+}
+
+// TestConfiguratorConcurrentCustomViews exercises concurrent RefreshViewStyles
+// calls against concurrent CustomViews/StylesForGVR reads. Run with
+// `go test -race`: the writer swaps the per-GVR style overlays, which
+// StylesForGVR reads under viewStylesMx; the customView snapshot is only
+// read here, never written.
+func TestConfiguratorConcurrentCustomViews(t *testing.T) {
+	var c Configurator
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			c.RefreshViewStyles([]byte("views:\n  v1:\n    style:\n      title: foo\n"))
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			_ = c.CustomViews()
+			_ = c.StylesForGVR("v1")
+		}
+	}()
+
+	wg.Wait()
+}
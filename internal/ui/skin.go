@@ -0,0 +1,245 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+)
+
+// skinsDir is the directory, relative to K9sHome, where theme packs live
+// alongside the existing flat `~/.k9s/<name>_skin.yml` layout.
+const skinsDir = "skins"
+
+// skinMeta is the optional top-level `meta:` block a skin file may declare.
+type skinMeta struct {
+	Description string `yaml:"description"`
+	Author      string `yaml:"author"`
+}
+
+// skinDoc is the subset of a skin file k9s needs to resolve composition,
+// everything else is captured in Rest and handed untouched to config.Styles.
+type skinDoc struct {
+	Extends  string                 `yaml:"extends,omitempty"`
+	Includes []string               `yaml:"includes,omitempty"`
+	Meta     skinMeta               `yaml:"meta,omitempty"`
+	Rest     map[string]interface{} `yaml:",inline"`
+}
+
+// SkinMeta describes a skin file discovered by ListSkins.
+type SkinMeta struct {
+	Name        string
+	Path        string
+	Description string
+	Author      string
+}
+
+// skinDirs returns the directories searched for skin files, in lookup
+// order: the classic flat `~/.k9s` layout, then the `~/.k9s/skins` theme
+// pack directory.
+func (c *Configurator) skinDirs() []string {
+	return []string{
+		config.K9sHome(),
+		filepath.Join(config.K9sHome(), skinsDir),
+	}
+}
+
+// findSkinFile locates a skin by name (no extension) across skinDirs,
+// trying each of the supported file extensions in turn.
+func (c *Configurator) findSkinFile(name string) (string, error) {
+	for _, dir := range c.skinDirs() {
+		for _, ext := range config.K9sStylesFileExtensions {
+			path := filepath.Join(dir, fmt.Sprintf("%s.%s", name, ext))
+			if _, err := os.Stat(path); err == nil {
+				return path, nil
+			}
+		}
+	}
+	return "", os.ErrNotExist
+}
+
+// noopCleanup is returned by resolveSkinFile when fileName needed no
+// composition, so callers can always unconditionally defer the cleanup.
+func noopCleanup() {}
+
+// resolveSkinFile resolves `extends`/`includes` directives declared in
+// fileName into a single effective skin file ready to be handed to
+// config.Styles.Load. When the skin declares no composition, fileName is
+// returned unchanged and cleanup is a no-op. Otherwise the merged result is
+// staged in a temp file and cleanup removes it -- callers must defer it.
+func (c *Configurator) resolveSkinFile(fileName string) (effective string, cleanup func(), err error) {
+	raw, err := os.ReadFile(fileName)
+	if err != nil {
+		return "", noopCleanup, err
+	}
+
+	var doc skinDoc
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return "", noopCleanup, err
+	}
+	if doc.Extends == "" && len(doc.Includes) == 0 {
+		return fileName, noopCleanup, nil
+	}
+
+	merged, err := c.resolveSkinChain(fileName, map[string]bool{})
+	if err != nil {
+		return "", noopCleanup, err
+	}
+
+	out, err := yaml.Marshal(merged)
+	if err != nil {
+		return "", noopCleanup, err
+	}
+
+	tmp, err := os.CreateTemp("", "k9s-skin-*.yml")
+	if err != nil {
+		return "", noopCleanup, err
+	}
+	defer tmp.Close()
+	if _, err := tmp.Write(out); err != nil {
+		os.Remove(tmp.Name())
+		return "", noopCleanup, err
+	}
+
+	return tmp.Name(), func() { os.Remove(tmp.Name()) }, nil
+}
+
+// resolveSkinChain walks the extends/includes graph rooted at path,
+// deep-merging each skin in declaration order so the child always wins
+// over what it extends or includes. visited tracks the current ancestor
+// path (not every file ever seen), so a diamond -- two branches both
+// extending/including the same common base -- resolves fine; only a true
+// cycle back to an ancestor trips the error.
+func (c *Configurator) resolveSkinChain(path string, visited map[string]bool) (map[string]interface{}, error) {
+	if visited[path] {
+		return nil, fmt.Errorf("circular skin reference detected at %s", path)
+	}
+	visited[path] = true
+	defer delete(visited, path)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc skinDoc
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+
+	merged := map[string]interface{}{}
+	if doc.Extends != "" {
+		parent, err := c.findSkinFile(doc.Extends)
+		if err != nil {
+			return nil, fmt.Errorf("skin %s extends unknown skin %q: %w", path, doc.Extends, err)
+		}
+		if merged, err = c.resolveSkinChain(parent, visited); err != nil {
+			return nil, err
+		}
+	}
+	for _, inc := range doc.Includes {
+		incPath, err := c.findSkinFile(inc)
+		if err != nil {
+			return nil, fmt.Errorf("skin %s includes unknown skin %q: %w", path, inc, err)
+		}
+		included, err := c.resolveSkinChain(incPath, visited)
+		if err != nil {
+			return nil, err
+		}
+		merged = mergeSkinMaps(merged, included)
+	}
+
+	return mergeSkinMaps(merged, doc.Rest), nil
+}
+
+// mergeSkinMaps deep-merges src over dst, recursing into nested maps so a
+// skin can override a single color without repeating its whole parent.
+func mergeSkinMaps(dst, src map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(dst))
+	for k, v := range dst {
+		out[k] = v
+	}
+	for k, v := range src {
+		if srcMap, ok := v.(map[string]interface{}); ok {
+			if dstMap, ok := out[k].(map[string]interface{}); ok {
+				out[k] = mergeSkinMaps(dstMap, srcMap)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// ListSkins scans ~/.k9s and ~/.k9s/skins for skin files and returns their
+// metadata, for a future `:skin` command palette to pick from.
+func (c *Configurator) ListSkins() ([]SkinMeta, error) {
+	var metas []SkinMeta
+
+	for _, dir := range c.skinDirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, e := range entries {
+			if e.IsDir() {
+				continue
+			}
+			ext := strings.TrimPrefix(filepath.Ext(e.Name()), ".")
+			if !isStylesExtension(ext) {
+				continue
+			}
+
+			path := filepath.Join(dir, e.Name())
+			raw, err := os.ReadFile(path)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Unable to read skin file %s", path)
+				continue
+			}
+			var doc skinDoc
+			if err := yaml.Unmarshal(raw, &doc); err != nil {
+				log.Warn().Err(err).Msgf("Unable to parse skin file %s", path)
+				continue
+			}
+
+			metas = append(metas, SkinMeta{
+				Name:        strings.TrimSuffix(e.Name(), filepath.Ext(e.Name())),
+				Path:        path,
+				Description: doc.Meta.Description,
+				Author:      doc.Meta.Author,
+			})
+		}
+	}
+
+	return metas, nil
+}
+
+func isStylesExtension(ext string) bool {
+	for _, e := range config.K9sStylesFileExtensions {
+		if e == ext {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplySkin loads and applies the named skin immediately, bypassing the
+// usual cluster/config driven resolution in RefreshStyles. Intended for an
+// interactive `:skin` command palette.
+func (c *Configurator) ApplySkin(name string) error {
+	c.setLastSkinError(nil)
+	if !c.updateStylesWithFile(config.NewStyles(), name) {
+		if err := c.LastSkinError(); err != nil {
+			return err
+		}
+		return fmt.Errorf("no skin named %q found in %s", name, strings.Join(c.skinDirs(), ", "))
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package ui
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed schema/skin.schema.json
+var skinSchemaFS embed.FS
+
+// jsonSchema is the subset of JSON Schema (draft-07) skin.schema.json
+// actually uses: object/array/string typing, properties, pattern and
+// $ref/definitions. It exists so validating a skin file doesn't pull in an
+// external dependency this tree has no go.mod/go.sum to pin.
+type jsonSchema struct {
+	Type        string                 `json:"type,omitempty"`
+	Pattern     string                 `json:"pattern,omitempty"`
+	Properties  map[string]*jsonSchema `json:"properties,omitempty"`
+	Items       *jsonSchema            `json:"items,omitempty"`
+	Ref         string                 `json:"$ref,omitempty"`
+	Definitions map[string]*jsonSchema `json:"definitions,omitempty"`
+}
+
+var (
+	skinSchemaOnce sync.Once
+	skinSchema     *jsonSchema
+	skinSchemaErr  error
+)
+
+func compiledSkinSchema() (*jsonSchema, error) {
+	skinSchemaOnce.Do(func() {
+		raw, err := skinSchemaFS.ReadFile("schema/skin.schema.json")
+		if err != nil {
+			skinSchemaErr = err
+			return
+		}
+		skinSchema = new(jsonSchema)
+		skinSchemaErr = json.Unmarshal(raw, skinSchema)
+	})
+	return skinSchema, skinSchemaErr
+}
+
+// SkinFieldError reports a single schema violation in a skin file: where it
+// occurred, what was expected, and, for a pattern-constrained field such as
+// a color, the regex it failed to match. The schema validates colors by
+// pattern rather than a fixed enum (a hex value or any bare color name both
+// match), so there is no finite "allowed values" list to report here.
+type SkinFieldError struct {
+	Path    string
+	Message string
+	Pattern string
+}
+
+// SkinValidationError is returned by ValidateSkinFile when a skin fails
+// schema validation. It carries one SkinFieldError per offending field
+// instead of the single free-form "Failed to parse skin file" message
+// loadSkinFile used to log.
+type SkinValidationError struct {
+	File   string
+	Fields []SkinFieldError
+}
+
+func (e *SkinValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "skin %s failed validation:", e.File)
+	for _, f := range e.Fields {
+		fmt.Fprintf(&b, "\n  %s: %s", f.Path, f.Message)
+		if f.Pattern != "" {
+			fmt.Fprintf(&b, " (expected to match: %s)", f.Pattern)
+		}
+	}
+	return b.String()
+}
+
+// ValidateSkinFile validates the YAML skin at path against the embedded
+// config.Styles JSON Schema, returning a *SkinValidationError describing
+// every offending field when it doesn't conform.
+func ValidateSkinFile(path string) error {
+	schema, err := compiledSkinSchema()
+	if err != nil {
+		return err
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var doc interface{}
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+	doc = normalizeYAML(doc)
+
+	var fields []SkinFieldError
+	validateNode(schema, schema, doc, "$", &fields)
+	if len(fields) > 0 {
+		return &SkinValidationError{File: path, Fields: fields}
+	}
+
+	return nil
+}
+
+// normalizeYAML converts the map[interface{}]interface{} that yaml.v3 can
+// produce for nested mappings into map[string]interface{} so validateNode
+// only has to deal with one map shape, the same one encoding/json produces.
+func normalizeYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			out[k] = normalizeYAML(val)
+		}
+		return out
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			out[fmt.Sprintf("%v", k)] = normalizeYAML(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = normalizeYAML(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// resolve follows a "#/definitions/name" $ref against root's Definitions.
+func resolve(root *jsonSchema, s *jsonSchema) *jsonSchema {
+	if s == nil || s.Ref == "" {
+		return s
+	}
+	name := strings.TrimPrefix(s.Ref, "#/definitions/")
+	return root.Definitions[name]
+}
+
+// validateNode walks doc against s, appending one SkinFieldError per
+// offending field. Sibling keys of an object are visited in Go map order, so
+// the order of the reported errors is not defined.
+func validateNode(root, s *jsonSchema, doc interface{}, path string, fields *[]SkinFieldError) {
+	s = resolve(root, s)
+	if s == nil || doc == nil {
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		m, ok := doc.(map[string]interface{})
+		if !ok {
+			*fields = append(*fields, SkinFieldError{Path: path, Message: "expected an object"})
+			return
+		}
+		for k, v := range m {
+			prop, known := s.Properties[k]
+			if !known {
+				// Unknown keys are tolerated: this schema was authored
+				// without access to the real config.Styles struct, so
+				// rejecting fields it doesn't yet know about would hard-fail
+				// otherwise-valid skins.
+				continue
+			}
+			validateNode(root, prop, v, path+"."+k, fields)
+		}
+	case "array":
+		a, ok := doc.([]interface{})
+		if !ok {
+			*fields = append(*fields, SkinFieldError{Path: path, Message: "expected an array"})
+			return
+		}
+		for i, v := range a {
+			validateNode(root, s.Items, v, fmt.Sprintf("%s[%d]", path, i), fields)
+		}
+	case "string":
+		str, ok := doc.(string)
+		if !ok {
+			*fields = append(*fields, SkinFieldError{Path: path, Message: "expected a string"})
+			return
+		}
+		if s.Pattern != "" {
+			re, err := regexp.Compile(s.Pattern)
+			if err == nil && !re.MatchString(str) {
+				*fields = append(*fields, SkinFieldError{
+					Path:    path,
+					Message: fmt.Sprintf("%q does not match pattern %s", str, s.Pattern),
+					Pattern: s.Pattern,
+				})
+			}
+		}
+	}
+}
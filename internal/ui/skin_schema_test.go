@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateSkinFileAccepts(t *testing.T) {
+	dir := t.TempDir()
+	write(t, dir, "good", "k9s:\n  body:\n    fgColor: white\n    bgColor: \"#1a1b26\"\n")
+
+	if err := ValidateSkinFile(filepath.Join(dir, "good.yml")); err != nil {
+		t.Fatalf("expected a valid skin to pass, got: %v", err)
+	}
+}
+
+func TestValidateSkinFileAcceptsNumberedColorNames(t *testing.T) {
+	dir := t.TempDir()
+	// tcell's GetColor also accepts 256-palette "colorNNN" names and
+	// numbered X11 names like "grey42" -- both alphanumeric, not just
+	// alphabetic.
+	write(t, dir, "numbered", "k9s:\n  body:\n    fgColor: color208\n    bgColor: grey42\n")
+
+	if err := ValidateSkinFile(filepath.Join(dir, "numbered.yml")); err != nil {
+		t.Fatalf("expected colorNNN/grey42-style color names to pass, got: %v", err)
+	}
+}
+
+func TestValidateSkinFileRejectsBadColor(t *testing.T) {
+	dir := t.TempDir()
+	write(t, dir, "bad", "k9s:\n  body:\n    fgColor: \"not a color!\"\n")
+
+	err := ValidateSkinFile(filepath.Join(dir, "bad.yml"))
+	if err == nil {
+		t.Fatal("expected an invalid color to fail validation")
+	}
+
+	ve, ok := err.(*SkinValidationError)
+	if !ok {
+		t.Fatalf("expected *SkinValidationError, got %T", err)
+	}
+	if len(ve.Fields) != 1 || ve.Fields[0].Path != "$.k9s.body.fgColor" {
+		t.Fatalf("expected a single fgColor violation, got %+v", ve.Fields)
+	}
+	if ve.Fields[0].Pattern == "" {
+		t.Fatal("expected the violated color pattern to be reported")
+	}
+	if !strings.Contains(ve.Error(), "expected to match") {
+		t.Fatalf("expected the error message to surface the pattern, got: %s", ve.Error())
+	}
+}
+
+func TestValidateSkinFileTolerantOfUnknownFields(t *testing.T) {
+	dir := t.TempDir()
+	// A field the schema doesn't know about yet -- since the schema was
+	// authored without the real config.Styles struct to check against, an
+	// unknown field must not hard-fail validation.
+	write(t, dir, "future", "k9s:\n  body:\n    fgColor: white\n    sparkleColor: gold\n")
+
+	if err := ValidateSkinFile(filepath.Join(dir, "future.yml")); err != nil {
+		t.Fatalf("expected an unknown field to be tolerated, got: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMergeSkinMapsDeepOverride(t *testing.T) {
+	dst := map[string]interface{}{
+		"k9s": map[string]interface{}{
+			"body": map[string]interface{}{"fgColor": "white", "bgColor": "black"},
+		},
+	}
+	src := map[string]interface{}{
+		"k9s": map[string]interface{}{
+			"body": map[string]interface{}{"fgColor": "red"},
+		},
+	}
+
+	out := mergeSkinMaps(dst, src)
+
+	body := out["k9s"].(map[string]interface{})["body"].(map[string]interface{})
+	if body["fgColor"] != "red" {
+		t.Fatalf("expected child fgColor to win, got %v", body["fgColor"])
+	}
+	if body["bgColor"] != "black" {
+		t.Fatalf("expected untouched parent key to survive the merge, got %v", body["bgColor"])
+	}
+}
+
+func TestResolveSkinChainDiamondIsNotACycle(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("K9S_CONFIG_DIR", dir)
+
+	write(t, dir, "base", "k9s:\n  body:\n    fgColor: white\n")
+	write(t, dir, "left", "extends: base\nk9s:\n  body:\n    bgColor: blue\n")
+	write(t, dir, "right", "extends: base\nk9s:\n  body:\n    bgColor: green\n")
+	write(t, dir, "diamond", "includes: [left, right]\n")
+
+	var c Configurator
+	merged, err := c.resolveSkinChain(filepath.Join(dir, "diamond.yml"), map[string]bool{})
+	if err != nil {
+		t.Fatalf("expected a diamond dependency to resolve cleanly, got error: %v", err)
+	}
+
+	body := merged["k9s"].(map[string]interface{})["body"].(map[string]interface{})
+	if body["fgColor"] != "white" {
+		t.Errorf("expected the shared base's fgColor to survive the merge, got %v", body["fgColor"])
+	}
+	if body["bgColor"] != "green" {
+		t.Errorf("expected the later include (right) to win over left, got %v", body["bgColor"])
+	}
+}
+
+func TestResolveSkinChainDetectsRealCycle(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("K9S_CONFIG_DIR", dir)
+
+	write(t, dir, "a", "extends: b\n")
+	write(t, dir, "b", "extends: a\n")
+
+	var c Configurator
+	if _, err := c.resolveSkinChain(filepath.Join(dir, "a.yml"), map[string]bool{}); err == nil {
+		t.Fatal("expected a circular skin reference error")
+	}
+}
+
+// TestApplySkinClearsStaleLastSkinError guards against ApplySkin leaving a
+// prior RefreshStyles validation failure behind: LastSkinError documents
+// itself as "the error from the most recent skin load", so a successful
+// ApplySkin must clear whatever an earlier failed reload left in place.
+func TestApplySkinClearsStaleLastSkinError(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("K9S_CONFIG_DIR", dir)
+	write(t, dir, "good", "k9s:\n  body:\n    fgColor: white\n")
+
+	var c Configurator
+	c.setLastSkinError(fmt.Errorf("stale validation failure from an earlier reload"))
+
+	if err := c.ApplySkin("good"); err != nil {
+		t.Fatalf("expected applying a valid skin to succeed, got: %v", err)
+	}
+	if err := c.LastSkinError(); err != nil {
+		t.Fatalf("expected ApplySkin to clear the stale error, got: %v", err)
+	}
+}
+
+func write(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name+".yml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v3"
+)
+
+// ViewStyle holds the subset of config.Styles fields a CustomView entry may
+// override for just its own resource view -- table header/row colors,
+// status colors and title -- layered on top of the active skin.
+type ViewStyle struct {
+	Table  *ViewTableStyle  `yaml:"table,omitempty"`
+	Status *ViewStatusStyle `yaml:"status,omitempty"`
+	Title  string           `yaml:"title,omitempty"`
+}
+
+// ViewTableStyle overrides a resource table's header/row colors.
+type ViewTableStyle struct {
+	HeaderFgColor string `yaml:"headerFgColor,omitempty"`
+	HeaderBgColor string `yaml:"headerBgColor,omitempty"`
+	RowFgColor    string `yaml:"rowFgColor,omitempty"`
+	RowBgColor    string `yaml:"rowBgColor,omitempty"`
+}
+
+// ViewStatusStyle overrides a resource view's status indicator colors.
+type ViewStatusStyle struct {
+	NewColor       string `yaml:"newColor,omitempty"`
+	ModifyColor    string `yaml:"modifyColor,omitempty"`
+	ErrorColor     string `yaml:"errorColor,omitempty"`
+	PendingColor   string `yaml:"pendingColor,omitempty"`
+	CompletedColor string `yaml:"completedColor,omitempty"`
+}
+
+// viewsDoc mirrors the per-GVR `style:` block views.yml may carry, which
+// config.CustomView does not itself know how to interpret.
+type viewsDoc struct {
+	Views map[string]struct {
+		Style *ViewStyle `yaml:"style,omitempty"`
+	} `yaml:"views"`
+}
+
+// RefreshViewStyles parses raw -- the already-loaded bytes of views.yml -- for
+// per-GVR `style:` overrides and republishes them. It's called out of
+// RefreshCustomViews with the bytes of views.yml read there, so it does not
+// open views.yml itself.
+func (c *Configurator) RefreshViewStyles(raw []byte) {
+	overlays := make(map[string]*ViewStyle)
+
+	var doc viewsDoc
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		log.Warn().Err(err).Msgf("Unable to parse view style overrides %s", config.K9sViewConfigFile)
+		c.setViewStyles(overlays)
+		return
+	}
+	for gvr, v := range doc.Views {
+		if v.Style != nil {
+			overlays[gvr] = v.Style
+		}
+	}
+
+	c.setViewStyles(overlays)
+}
+
+func (c *Configurator) setViewStyles(overlays map[string]*ViewStyle) {
+	c.viewStylesMx.Lock()
+	defer c.viewStylesMx.Unlock()
+	c.viewStyles = overlays
+}
+
+// StylesForGVR returns the per-view style overlay declared for gvr, if any,
+// for the render/view layer to layer on top of the active skin. Returns nil
+// when no custom view for gvr declares a style block.
+func (c *Configurator) StylesForGVR(gvr string) *ViewStyle {
+	c.viewStylesMx.RLock()
+	defer c.viewStylesMx.RUnlock()
+	return c.viewStyles[gvr]
+}
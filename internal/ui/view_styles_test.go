@@ -0,0 +1,37 @@
+package ui
+
+import "testing"
+
+func TestRefreshViewStylesParsesOverlay(t *testing.T) {
+	var c Configurator
+	c.RefreshViewStyles([]byte("views:\n  v1:\n    style:\n      title: Pods\n      table:\n        headerFgColor: red\n"))
+
+	vs := c.StylesForGVR("v1")
+	if vs == nil {
+		t.Fatal("expected a style overlay for v1")
+	}
+	if vs.Title != "Pods" {
+		t.Errorf("expected title Pods, got %q", vs.Title)
+	}
+	if vs.Table == nil || vs.Table.HeaderFgColor != "red" {
+		t.Errorf("expected table.headerFgColor red, got %+v", vs.Table)
+	}
+}
+
+func TestRefreshViewStylesSkipsGVRWithoutStyle(t *testing.T) {
+	var c Configurator
+	c.RefreshViewStyles([]byte("views:\n  v1:\n    columns: [NAME]\n"))
+
+	if vs := c.StylesForGVR("v1"); vs != nil {
+		t.Errorf("expected no overlay for a view without a style block, got %+v", vs)
+	}
+}
+
+func TestRefreshViewStylesInvalidYAML(t *testing.T) {
+	var c Configurator
+	c.RefreshViewStyles([]byte(": not valid yaml"))
+
+	if vs := c.StylesForGVR("v1"); vs != nil {
+		t.Errorf("expected no overlay after a parse failure, got %+v", vs)
+	}
+}